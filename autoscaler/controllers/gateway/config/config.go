@@ -0,0 +1,49 @@
+package config
+
+import (
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	commonconf "github.com/odigos-io/odigos/autoscaler/controllers/common"
+	"github.com/odigos-io/odigos/common"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Configurer is implemented by every destination type's config modifier, and
+// is what dispatches a Destination to the code that knows how to turn it
+// into collector exporters/pipelines.
+type Configurer interface {
+	DestType() common.DestinationType
+	ModifyConfig(dest *odigosv1.Destination, data *ParsedDestinationData, currentConfig *commonconf.Config)
+}
+
+var availableConfigurers = []Configurer{
+	&Quickwit{},
+	&NewRelic{},
+	&Sentry{},
+	&Splunk{},
+	&GrafanaCloudLoki{},
+	&GrafanaCloudPrometheus{},
+	&OTLPHttp{},
+}
+
+// ApplyDestination is syncConfigMap's entrypoint for a single Destination: it
+// validates Spec.Data against the type's registered DestinationSchema, and
+// only calls into the matching Configurer's ModifyConfig once that passes,
+// handing it the validated data instead of the raw map.
+func ApplyDestination(dest *odigosv1.Destination, currentConfig *commonconf.Config) {
+	for _, configurer := range availableConfigurers {
+		if configurer.DestType() != dest.Spec.Type {
+			continue
+		}
+
+		data, err := ValidateDestinationData(dest.Spec.Type, dest.Spec.Data)
+		if err != nil {
+			log.Log.Error(err, "destination failed schema validation, gateway will not be configured for it", "name", dest.Name)
+			return
+		}
+
+		configurer.ModifyConfig(dest, data, currentConfig)
+		return
+	}
+
+	log.Log.V(0).Info("No configurer registered for destination type", "type", dest.Spec.Type, "name", dest.Name)
+}
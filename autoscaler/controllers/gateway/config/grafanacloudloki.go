@@ -15,22 +15,35 @@ const (
 	grafanaCloudLokiEndpointKey = "GRAFANA_CLOUD_LOKI_ENDPOINT"
 	grafanaCloudLokiUsernameKey = "GRAFANA_CLOUD_LOKI_USERNAME"
 	grafanaCloudLokiLabelsKey   = "GRAFANA_CLOUD_LOKI_LABELS"
+	grafanaCloudLokiPasswordKey = "GRAFANA_CLOUD_LOKI_PASSWORD"
 )
 
+func init() {
+	RegisterDestinationSchema(DestinationSchema{
+		Type: common.GrafanaCloudLokiDestinationType,
+		Fields: []DestinationSchemaField{
+			{Name: grafanaCloudLokiEndpointKey, Kind: FieldKindURL, Required: true, PathMustBe: "/loki/api/v1/push"},
+			{Name: grafanaCloudLokiUsernameKey, Kind: FieldKindString, Required: true},
+			{Name: grafanaCloudLokiLabelsKey, Kind: FieldKindJSONList, Required: false},
+			{Name: grafanaCloudLokiPasswordKey, Kind: FieldKindSecret, Required: true, SecretEnvVar: grafanaCloudLokiPasswordKey},
+		},
+	})
+}
+
 type GrafanaCloudLoki struct{}
 
 func (g *GrafanaCloudLoki) DestType() common.DestinationType {
 	return common.GrafanaCloudLokiDestinationType
 }
 
-func (g *GrafanaCloudLoki) ModifyConfig(dest *odigosv1.Destination, currentConfig *commonconf.Config) {
+func (g *GrafanaCloudLoki) ModifyConfig(dest *odigosv1.Destination, data *ParsedDestinationData, currentConfig *commonconf.Config) {
 
 	if !isLoggingEnabled(dest) {
 		log.Log.V(0).Info("Logging not enabled, gateway will not be configured for grafana cloud Loki")
 		return
 	}
 
-	lokiUrl, exists := dest.Spec.Data[grafanaCloudLokiEndpointKey]
+	lokiUrl, exists := data.String(grafanaCloudLokiEndpointKey)
 	if !exists {
 		log.Log.V(0).Info("Grafana Cloud Loki endpoint not specified, gateway will not be configured for Loki")
 		return
@@ -42,13 +55,13 @@ func (g *GrafanaCloudLoki) ModifyConfig(dest *odigosv1.Destination, currentConfi
 		return
 	}
 
-	lokiUsername, exists := dest.Spec.Data[grafanaCloudLokiUsernameKey]
+	lokiUsername, exists := data.String(grafanaCloudLokiUsernameKey)
 	if !exists {
 		log.Log.V(0).Info("Grafana Cloud Loki username not specified, gateway will not be configured for Loki")
 		return
 	}
 
-	rawLokiLabels, exists := dest.Spec.Data[grafanaCloudLokiLabelsKey]
+	rawLokiLabels, exists := data.String(grafanaCloudLokiLabelsKey)
 	lokiProcessors, err := lokiLabelsProcessors(rawLokiLabels, exists, dest.Name)
 	if err != nil {
 		log.Log.Error(err, "failed to parse grafana cloud loki labels, gateway will not be configured for Loki")
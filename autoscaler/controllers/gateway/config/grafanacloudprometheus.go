@@ -16,22 +16,36 @@ const (
 	grafanaCloudPrometheusUserKey         = "GRAFANA_CLOUD_PROMETHEUS_USERNAME"
 	prometheusResourceAttributesLabelsKey = "PROMETHEUS_RESOURCE_ATTRIBUTES_LABELS"
 	prometheusExternalLabelsKey           = "PROMETHEUS_RESOURCE_EXTERNAL_LABELS"
+	grafanaCloudPrometheusPasswordKey     = "GRAFANA_CLOUD_PROMETHEUS_PASSWORD"
 )
 
+func init() {
+	RegisterDestinationSchema(DestinationSchema{
+		Type: common.GrafanaCloudPrometheusDestinationType,
+		Fields: []DestinationSchemaField{
+			{Name: grafanaCloudPrometheusRWurlKey, Kind: FieldKindURL, Required: true, PathMustBe: "/api/prom/push"},
+			{Name: grafanaCloudPrometheusUserKey, Kind: FieldKindString, Required: true},
+			{Name: prometheusResourceAttributesLabelsKey, Kind: FieldKindJSONList, Required: false},
+			{Name: prometheusExternalLabelsKey, Kind: FieldKindString, Required: false},
+			{Name: grafanaCloudPrometheusPasswordKey, Kind: FieldKindSecret, Required: true, SecretEnvVar: grafanaCloudPrometheusPasswordKey},
+		},
+	})
+}
+
 type GrafanaCloudPrometheus struct{}
 
 func (g *GrafanaCloudPrometheus) DestType() common.DestinationType {
 	return common.GrafanaCloudPrometheusDestinationType
 }
 
-func (g *GrafanaCloudPrometheus) ModifyConfig(dest *odigosv1.Destination, currentConfig *commonconf.Config) {
+func (g *GrafanaCloudPrometheus) ModifyConfig(dest *odigosv1.Destination, data *ParsedDestinationData, currentConfig *commonconf.Config) {
 
 	if !isMetricsEnabled(dest) {
 		log.Log.V(0).Info("Metrics not enabled, gateway will not be configured for grafana cloud prometheus")
 		return
 	}
 
-	promRwUrl, exists := dest.Spec.Data[grafanaCloudPrometheusRWurlKey]
+	promRwUrl, exists := data.String(grafanaCloudPrometheusRWurlKey)
 	if !exists {
 		log.Log.V(0).Info("Grafana Cloud Prometheus remote write endpoint not specified, gateway will not be configured for Prometheus")
 		return
@@ -42,13 +56,13 @@ func (g *GrafanaCloudPrometheus) ModifyConfig(dest *odigosv1.Destination, curren
 		return
 	}
 
-	prometheusUsername, exists := dest.Spec.Data[grafanaCloudPrometheusUserKey]
+	prometheusUsername, exists := data.String(grafanaCloudPrometheusUserKey)
 	if !exists {
 		log.Log.V(0).Info("Grafana Cloud Prometheus username not specified, gateway will not be configured for Prometheus")
 		return
 	}
 
-	resourceAttributesLabels, exists := dest.Spec.Data[prometheusResourceAttributesLabelsKey]
+	resourceAttributesLabels, exists := data.String(prometheusResourceAttributesLabelsKey)
 	processors, err := promResourceAttributesProcessors(resourceAttributesLabels, exists, dest.Name)
 	if err != nil {
 		log.Log.Error(err, "failed to parse grafana cloud prometheus resource attributes labels, gateway will not be configured for Prometheus")
@@ -72,7 +86,7 @@ func (g *GrafanaCloudPrometheus) ModifyConfig(dest *odigosv1.Destination, curren
 	}
 
 	// add external labels if they exist
-	externalLabels, exists := dest.Spec.Data[prometheusExternalLabelsKey]
+	externalLabels, exists := data.String(prometheusExternalLabelsKey)
 	if exists {
 		labels := map[string]string{}
 		err := json.Unmarshal([]byte(externalLabels), &labels)
@@ -0,0 +1,106 @@
+package config
+
+import (
+	"strings"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	commonconf "github.com/odigos-io/odigos/autoscaler/controllers/common"
+)
+
+const k8sAttributesProcessorName = "k8sattributes"
+
+// ApplyK8sAttributesProcessor inserts the OTel Collector `k8sattributes`
+// processor at the front of every traces/metrics/logs pipeline built for the
+// gateway, when enabled via OdigosConfiguration.Spec.K8sAttributes. This
+// removes the need for every destination to hand-list it as one of their own
+// processors.
+func ApplyK8sAttributesProcessor(odigosConfig *odigosv1.OdigosConfiguration, currentConfig *commonconf.Config) {
+	settings := odigosConfig.Spec.K8sAttributes
+	if settings == nil || !settings.Enabled {
+		return
+	}
+
+	currentConfig.Processors[k8sAttributesProcessorName] = k8sAttributesProcessorConfig(settings)
+
+	for pipelineName, pipeline := range currentConfig.Service.Pipelines {
+		if !isSignalPipeline(pipelineName) {
+			continue
+		}
+
+		currentConfig.Service.Pipelines[pipelineName] = prependProcessor(pipeline, k8sAttributesProcessorName)
+	}
+}
+
+// prependProcessor threads a processor onto the front of a pipeline's
+// existing processor chain. It's the shared helper pipeline-mutating code
+// should go through instead of hand-splicing Processors slices inline, so
+// every caller gets the same "runs before whatever the destination already
+// configured" ordering.
+func prependProcessor(pipeline commonconf.Pipeline, processorName string) commonconf.Pipeline {
+	pipeline.Processors = append([]string{processorName}, pipeline.Processors...)
+	return pipeline
+}
+
+func isSignalPipeline(pipelineName string) bool {
+	return strings.HasPrefix(pipelineName, "traces/") ||
+		strings.HasPrefix(pipelineName, "metrics/") ||
+		strings.HasPrefix(pipelineName, "logs/")
+}
+
+func k8sAttributesProcessorConfig(settings *odigosv1.K8sAttributesConfig) commonconf.GenericMap {
+	extract := commonconf.GenericMap{}
+	if len(settings.Labels) > 0 {
+		extract["labels"] = settings.Labels
+	}
+	if len(settings.Annotations) > 0 {
+		extract["annotations"] = settings.Annotations
+	}
+
+	metadata := []string{}
+	if settings.PodUID {
+		metadata = append(metadata, "k8s.pod.uid")
+	}
+	if settings.DeploymentName {
+		metadata = append(metadata, "k8s.deployment.name")
+	}
+	if settings.NodeName {
+		metadata = append(metadata, "k8s.node.name")
+	}
+	if settings.ContainerImage {
+		metadata = append(metadata, "container.image.name", "container.image.tag")
+	}
+	if len(metadata) > 0 {
+		extract["metadata"] = metadata
+	}
+
+	associations := []commonconf.GenericMap{}
+	for _, rule := range settings.AssociationRules {
+		switch rule.From {
+		case odigosv1.K8sAttributesAssociationConnection:
+			associations = append(associations, commonconf.GenericMap{
+				"sources": []commonconf.GenericMap{{"from": "connection"}},
+			})
+		case odigosv1.K8sAttributesAssociationResourceAttribute:
+			attrName := rule.ResourceAttribute
+			if attrName == "" {
+				continue
+			}
+			associations = append(associations, commonconf.GenericMap{
+				"sources": []commonconf.GenericMap{{"from": "resource_attribute", "name": attrName}},
+			})
+		case odigosv1.K8sAttributesAssociationPodIP:
+			associations = append(associations, commonconf.GenericMap{
+				"sources": []commonconf.GenericMap{{"from": "resource_attribute", "name": "k8s.pod.ip"}},
+			})
+		}
+	}
+
+	conf := commonconf.GenericMap{
+		"extract": extract,
+	}
+	if len(associations) > 0 {
+		conf["pod_association"] = associations
+	}
+
+	return conf
+}
@@ -11,16 +11,27 @@ import (
 
 const (
 	newRelicEndpoint = "NEWRELIC_ENDPOINT"
+	newRelicApiKey   = "NEWRELIC_API_KEY"
 )
 
+func init() {
+	RegisterDestinationSchema(DestinationSchema{
+		Type: common.NewRelicDestinationType,
+		Fields: []DestinationSchemaField{
+			{Name: newRelicEndpoint, Kind: FieldKindString, Required: true},
+			{Name: newRelicApiKey, Kind: FieldKindSecret, Required: true, SecretEnvVar: newRelicApiKey},
+		},
+	})
+}
+
 type NewRelic struct{}
 
 func (n *NewRelic) DestType() common.DestinationType {
 	return common.NewRelicDestinationType
 }
 
-func (n *NewRelic) ModifyConfig(dest *odigosv1.Destination, currentConfig *commonconf.Config) {
-	endpoint, exists := dest.Spec.Data[newRelicEndpoint]
+func (n *NewRelic) ModifyConfig(dest *odigosv1.Destination, data *ParsedDestinationData, currentConfig *commonconf.Config) {
+	endpoint, exists := data.String(newRelicEndpoint)
 	if !exists {
 		log.Log.V(0).Info("New relic endpoint not specified, gateway will not be configured for New Relic")
 		return
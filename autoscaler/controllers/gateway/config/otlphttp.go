@@ -0,0 +1,217 @@
+package config
+
+import (
+	"encoding/json"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	commonconf "github.com/odigos-io/odigos/autoscaler/controllers/common"
+	"github.com/odigos-io/odigos/common"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	otlpHttpEndpointKey    = "OTLP_HTTP_ENDPOINT"
+	otlpHttpCompressionKey = "OTLP_HTTP_COMPRESSION"
+	otlpHttpTimeoutKey     = "OTLP_HTTP_TIMEOUT"
+	otlpHttpHeadersKey     = "OTLP_HTTP_HEADERS"
+
+	otlpHttpTlsInsecureKey = "OTLP_HTTP_TLS_INSECURE"
+	otlpHttpTlsCaKey       = "OTLP_HTTP_TLS_CA_FILE"
+	otlpHttpTlsCertKey     = "OTLP_HTTP_TLS_CERT_FILE"
+	otlpHttpTlsKeyKey      = "OTLP_HTTP_TLS_KEY_FILE"
+
+	otlpHttpQueueEnabledKey         = "OTLP_HTTP_SENDING_QUEUE_ENABLED"
+	otlpHttpQueueNumConsumersKey    = "OTLP_HTTP_SENDING_QUEUE_NUM_CONSUMERS"
+	otlpHttpQueueSizeKey            = "OTLP_HTTP_SENDING_QUEUE_SIZE"
+	otlpHttpRetryEnabledKey         = "OTLP_HTTP_RETRY_ON_FAILURE_ENABLED"
+	otlpHttpRetryInitialIntervalKey = "OTLP_HTTP_RETRY_ON_FAILURE_INITIAL_INTERVAL"
+	otlpHttpRetryMaxIntervalKey     = "OTLP_HTTP_RETRY_ON_FAILURE_MAX_INTERVAL"
+	otlpHttpRetryMaxElapsedTimeKey  = "OTLP_HTTP_RETRY_ON_FAILURE_MAX_ELAPSED_TIME"
+)
+
+func init() {
+	RegisterDestinationSchema(DestinationSchema{
+		Type: common.OTLPHttpDestinationType,
+		Fields: []DestinationSchemaField{
+			{Name: otlpHttpEndpointKey, Kind: FieldKindURL, Required: true},
+			{Name: otlpHttpCompressionKey, Kind: FieldKindEnum, Required: false, AllowedValues: []string{"gzip", "zstd", "none"}},
+			{Name: otlpHttpTimeoutKey, Kind: FieldKindString, Required: false},
+			{Name: otlpHttpHeadersKey, Kind: FieldKindString, Required: false},
+			{Name: otlpHttpTlsCaKey, Kind: FieldKindString, Required: false},
+			{Name: otlpHttpTlsCertKey, Kind: FieldKindString, Required: false},
+			{Name: otlpHttpTlsKeyKey, Kind: FieldKindString, Required: false},
+			{Name: otlpHttpTlsInsecureKey, Kind: FieldKindEnum, Required: false, AllowedValues: []string{"true", "false"}},
+			{Name: otlpHttpQueueEnabledKey, Kind: FieldKindEnum, Required: false, AllowedValues: []string{"true", "false"}},
+			{Name: otlpHttpQueueNumConsumersKey, Kind: FieldKindString, Required: false, Regex: "^[0-9]+$"},
+			{Name: otlpHttpQueueSizeKey, Kind: FieldKindString, Required: false, Regex: "^[0-9]+$"},
+			{Name: otlpHttpRetryEnabledKey, Kind: FieldKindEnum, Required: false, AllowedValues: []string{"true", "false"}},
+			{Name: otlpHttpRetryInitialIntervalKey, Kind: FieldKindString, Required: false},
+			{Name: otlpHttpRetryMaxIntervalKey, Kind: FieldKindString, Required: false},
+			{Name: otlpHttpRetryMaxElapsedTimeKey, Kind: FieldKindString, Required: false},
+		},
+	})
+}
+
+type OTLPHttp struct{}
+
+func (o *OTLPHttp) DestType() common.DestinationType {
+	return common.OTLPHttpDestinationType
+}
+
+func (o *OTLPHttp) ModifyConfig(dest *odigosv1.Destination, data *ParsedDestinationData, currentConfig *commonconf.Config) {
+	endpoint, exists := data.String(otlpHttpEndpointKey)
+	if !exists {
+		log.Log.V(0).Info("OTLP/HTTP endpoint not specified, gateway will not be configured for OTLP/HTTP")
+		return
+	}
+
+	exporterConf := commonconf.GenericMap{
+		"endpoint": endpoint,
+	}
+
+	if compression, exists := data.String(otlpHttpCompressionKey); exists {
+		exporterConf["compression"] = compression
+	}
+
+	if timeout, exists := data.String(otlpHttpTimeoutKey); exists {
+		exporterConf["timeout"] = timeout
+	}
+
+	if rawHeaders, exists := data.String(otlpHttpHeadersKey); exists {
+		headers := map[string]string{}
+		if err := json.Unmarshal([]byte(rawHeaders), &headers); err != nil {
+			log.Log.Error(err, "failed to parse otlp http headers, gateway will not be configured for OTLP/HTTP")
+			return
+		}
+		exporterConf["headers"] = headers
+	}
+
+	exporterConf["tls"] = otlpHttpTlsConfig(data)
+
+	queueConf, err := otlpHttpSendingQueueConfig(data)
+	if err != nil {
+		log.Log.Error(err, "failed to parse otlp http sending queue config, gateway will not be configured for OTLP/HTTP")
+		return
+	}
+	if queueConf != nil {
+		exporterConf["sending_queue"] = queueConf
+	}
+
+	retryConf, err := otlpHttpRetryOnFailureConfig(data)
+	if err != nil {
+		log.Log.Error(err, "failed to parse otlp http retry_on_failure config, gateway will not be configured for OTLP/HTTP")
+		return
+	}
+	if retryConf != nil {
+		exporterConf["retry_on_failure"] = retryConf
+	}
+
+	exporterName := "otlphttp/otlphttp-" + dest.Name
+	currentConfig.Exporters[exporterName] = exporterConf
+
+	if isTracingEnabled(dest) {
+		tracesPipelineName := "traces/otlphttp-" + dest.Name
+		currentConfig.Service.Pipelines[tracesPipelineName] = commonconf.Pipeline{
+			Exporters: []string{exporterName},
+		}
+	}
+
+	if isMetricsEnabled(dest) {
+		metricsPipelineName := "metrics/otlphttp-" + dest.Name
+		currentConfig.Service.Pipelines[metricsPipelineName] = commonconf.Pipeline{
+			Exporters: []string{exporterName},
+		}
+	}
+
+	if isLoggingEnabled(dest) {
+		logsPipelineName := "logs/otlphttp-" + dest.Name
+		currentConfig.Service.Pipelines[logsPipelineName] = commonconf.Pipeline{
+			Exporters: []string{exporterName},
+		}
+	}
+}
+
+// otlpHttpTlsConfig builds the exporter's tls block. Cert/key/ca are expected
+// to be file paths mounted from a secret onto the gateway pod, mirroring how
+// the collector itself expects `tls.cert_file`/`key_file`/`ca_file`.
+func otlpHttpTlsConfig(data *ParsedDestinationData) commonconf.GenericMap {
+	insecure, _, _ := data.Bool(otlpHttpTlsInsecureKey)
+	tlsConf := commonconf.GenericMap{
+		"insecure": insecure,
+	}
+
+	if ca, exists := data.String(otlpHttpTlsCaKey); exists {
+		tlsConf["ca_file"] = ca
+	}
+	if cert, exists := data.String(otlpHttpTlsCertKey); exists {
+		tlsConf["cert_file"] = cert
+	}
+	if key, exists := data.String(otlpHttpTlsKeyKey); exists {
+		tlsConf["key_file"] = key
+	}
+
+	return tlsConf
+}
+
+// otlpHttpSendingQueueConfig parses the `sending_queue` tuning fields shared
+// by OTLP/HTTP style exporters. It returns a nil map when
+// OTLP_HTTP_SENDING_QUEUE_ENABLED is unset (the consumer/size fields are then
+// ignored too), so callers can skip the key entirely and fall back to the
+// collector defaults. An explicit "false" yields a map that disables the queue.
+func otlpHttpSendingQueueConfig(data *ParsedDestinationData) (commonconf.GenericMap, error) {
+	enabled, exists, err := data.Bool(otlpHttpQueueEnabledKey)
+	if !exists {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	queueConf := commonconf.GenericMap{
+		"enabled": enabled,
+	}
+
+	if numConsumers, exists, err := data.Int(otlpHttpQueueNumConsumersKey); exists {
+		if err != nil {
+			return nil, err
+		}
+		queueConf["num_consumers"] = numConsumers
+	}
+
+	if queueSize, exists, err := data.Int(otlpHttpQueueSizeKey); exists {
+		if err != nil {
+			return nil, err
+		}
+		queueConf["queue_size"] = queueSize
+	}
+
+	return queueConf, nil
+}
+
+// otlpHttpRetryOnFailureConfig parses the `retry_on_failure` tuning fields
+// shared by OTLP/HTTP style exporters, returning a nil map when unset.
+func otlpHttpRetryOnFailureConfig(data *ParsedDestinationData) (commonconf.GenericMap, error) {
+	enabled, exists, err := data.Bool(otlpHttpRetryEnabledKey)
+	if !exists {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	retryConf := commonconf.GenericMap{
+		"enabled": enabled,
+	}
+
+	if initialInterval, exists := data.String(otlpHttpRetryInitialIntervalKey); exists {
+		retryConf["initial_interval"] = initialInterval
+	}
+	if maxInterval, exists := data.String(otlpHttpRetryMaxIntervalKey); exists {
+		retryConf["max_interval"] = maxInterval
+	}
+	if maxElapsedTime, exists := data.String(otlpHttpRetryMaxElapsedTimeKey); exists {
+		retryConf["max_elapsed_time"] = maxElapsedTime
+	}
+
+	return retryConf, nil
+}
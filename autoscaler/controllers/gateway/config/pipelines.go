@@ -0,0 +1,58 @@
+package config
+
+import (
+	"regexp"
+	"strings"
+
+	commonconf "github.com/odigos-io/odigos/autoscaler/controllers/common"
+)
+
+// destinationPipelineNames returns the pipeline name(s) a destination's own
+// ModifyConfig already registered for a signal, matching the
+// `<signal>/<type>-<destName>` naming convention every destination file
+// follows. The vendor token is anchored to alphanumeric characters only, so a
+// destination named e.g. "relic" can't accidentally match another
+// destination's pipeline such as "metrics/newrelic-new-relic" just because
+// its name happens to be a suffix of one with a hyphen in it.
+func destinationPipelineNames(currentConfig *commonconf.Config, signal string, destName string) []string {
+	pattern := regexp.MustCompile("^" + regexp.QuoteMeta(signal+"/") + "[A-Za-z0-9]+-" + regexp.QuoteMeta(destName) + "$")
+
+	names := []string{}
+	for pipelineName := range currentConfig.Service.Pipelines {
+		if pattern.MatchString(pipelineName) {
+			names = append(names, pipelineName)
+		}
+	}
+	return names
+}
+
+// destinationExporters looks up the exporters already wired into a
+// destination's own pipeline(s) for a signal, so connectors can fan derived
+// signals out to it without knowing its exporter naming scheme.
+func destinationExporters(currentConfig *commonconf.Config, signal string, destName string) []string {
+	exporters := []string{}
+	for _, pipelineName := range destinationPipelineNames(currentConfig, signal, destName) {
+		exporters = append(exporters, currentConfig.Service.Pipelines[pipelineName].Exporters...)
+	}
+	return exporters
+}
+
+// attachConnectorAsExporter adds connectorName as an additional exporter on
+// every already-built pipeline for a signal, so a connector that derives a
+// new signal from an existing one (spanlogs deriving logs from traces,
+// servicegraph deriving metrics from traces) gets fed from the real ingest
+// pipelines instead of needing an orphan source pipeline of its own with no
+// receiver.
+func attachConnectorAsExporter(currentConfig *commonconf.Config, signal string, connectorName string) {
+	prefix := signal + "/"
+	for pipelineName, pipeline := range currentConfig.Service.Pipelines {
+		if !strings.HasPrefix(pipelineName, prefix) {
+			continue
+		}
+		if containsString(pipeline.Exporters, connectorName) {
+			continue
+		}
+		pipeline.Exporters = append(pipeline.Exporters, connectorName)
+		currentConfig.Service.Pipelines[pipelineName] = pipeline
+	}
+}
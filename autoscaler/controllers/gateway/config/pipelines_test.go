@@ -0,0 +1,87 @@
+package config
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	commonconf "github.com/odigos-io/odigos/autoscaler/controllers/common"
+)
+
+func newTestConfig(pipelines map[string]commonconf.Pipeline) *commonconf.Config {
+	return &commonconf.Config{
+		Service: commonconf.Service{
+			Pipelines: pipelines,
+		},
+	}
+}
+
+func TestDestinationPipelineNamesAvoidsSuffixCollisions(t *testing.T) {
+	cfg := newTestConfig(map[string]commonconf.Pipeline{
+		"metrics/newrelic-new-relic": {Exporters: []string{"otlp/newrelic-new-relic"}},
+		"metrics/grafana-relic":      {Exporters: []string{"prometheusremotewrite/grafana-relic"}},
+		"traces/newrelic-new-relic":  {Exporters: []string{"otlp/newrelic-new-relic"}},
+	})
+
+	got := destinationPipelineNames(cfg, "metrics", "relic")
+	want := []string{"metrics/grafana-relic"}
+	if !reflect.DeepEqual(sortedCopy(got), want) {
+		t.Fatalf("destinationPipelineNames(metrics, relic) = %v, want %v (must not match metrics/newrelic-new-relic)", got, want)
+	}
+
+	got = destinationPipelineNames(cfg, "metrics", "new-relic")
+	want = []string{"metrics/newrelic-new-relic"}
+	if !reflect.DeepEqual(sortedCopy(got), want) {
+		t.Fatalf("destinationPipelineNames(metrics, new-relic) = %v, want %v", got, want)
+	}
+}
+
+func TestDestinationExporters(t *testing.T) {
+	cfg := newTestConfig(map[string]commonconf.Pipeline{
+		"logs/quickwit-mydest": {Exporters: []string{"otlp/quickwit-mydest"}},
+		"logs/grafana-other":   {Exporters: []string{"loki/grafana-other"}},
+	})
+
+	got := destinationExporters(cfg, "logs", "mydest")
+	want := []string{"otlp/quickwit-mydest"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("destinationExporters(logs, mydest) = %v, want %v", got, want)
+	}
+
+	if got := destinationExporters(cfg, "logs", "nonexistent"); len(got) != 0 {
+		t.Fatalf("destinationExporters(logs, nonexistent) = %v, want empty", got)
+	}
+}
+
+func TestAttachConnectorAsExporter(t *testing.T) {
+	cfg := newTestConfig(map[string]commonconf.Pipeline{
+		"traces/newrelic-mydest":  {Exporters: []string{"otlp/newrelic-mydest"}},
+		"metrics/newrelic-mydest": {Exporters: []string{"otlp/newrelic-mydest"}},
+	})
+
+	attachConnectorAsExporter(cfg, "traces", "spanlogs/myaction")
+
+	tracesPipeline := cfg.Service.Pipelines["traces/newrelic-mydest"]
+	want := []string{"otlp/newrelic-mydest", "spanlogs/myaction"}
+	if !reflect.DeepEqual(tracesPipeline.Exporters, want) {
+		t.Fatalf("traces pipeline exporters = %v, want %v", tracesPipeline.Exporters, want)
+	}
+
+	metricsPipeline := cfg.Service.Pipelines["metrics/newrelic-mydest"]
+	if len(metricsPipeline.Exporters) != 1 {
+		t.Fatalf("metrics pipeline should be untouched, got %v", metricsPipeline.Exporters)
+	}
+
+	// calling it again must not duplicate the connector as an exporter.
+	attachConnectorAsExporter(cfg, "traces", "spanlogs/myaction")
+	tracesPipeline = cfg.Service.Pipelines["traces/newrelic-mydest"]
+	if !reflect.DeepEqual(tracesPipeline.Exporters, want) {
+		t.Fatalf("attachConnectorAsExporter should be idempotent, got %v, want %v", tracesPipeline.Exporters, want)
+	}
+}
+
+func sortedCopy(s []string) []string {
+	out := append([]string{}, s...)
+	sort.Strings(out)
+	return out
+}
@@ -10,14 +10,23 @@ const (
 	qwUrlKey = "QUICKWIT_URL"
 )
 
+func init() {
+	RegisterDestinationSchema(DestinationSchema{
+		Type: common.QuickwitDestinationType,
+		Fields: []DestinationSchemaField{
+			{Name: qwUrlKey, Kind: FieldKindURL, Required: true},
+		},
+	})
+}
+
 type Quickwit struct{}
 
 func (e *Quickwit) DestType() common.DestinationType {
 	return common.QuickwitDestinationType
 }
 
-func (e *Quickwit) ModifyConfig(dest *odigosv1.Destination, currentConfig *commonconf.Config) {
-	if url, exists := dest.Spec.Data[qwUrlKey]; exists {
+func (e *Quickwit) ModifyConfig(dest *odigosv1.Destination, data *ParsedDestinationData, currentConfig *commonconf.Config) {
+	if url, exists := data.String(qwUrlKey); exists {
 		exporterName := "otlp/quickwit-" + dest.Name
 
 		currentConfig.Exporters[exporterName] = commonconf.GenericMap{
@@ -0,0 +1,212 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	commonconf "github.com/odigos-io/odigos/autoscaler/controllers/common"
+)
+
+var routedSignals = []string{"traces", "metrics", "logs"}
+
+// ApplyRoutingConnectors multiplexes a single gateway across tenants: any
+// destination that declares Spec.Selector is routed to via the OTel
+// Collector `routing` connector instead of receiving every signal the
+// gateway sees. `<signal>/in` feeds `routing/<signal>`, which forwards each
+// span/datapoint/log record into the pipelines of every destination whose
+// selector matches, and separately into `default_pipelines` for records that
+// match no selector at all.
+//
+// Destinations without a selector are unaffected and keep receiving
+// everything: their pipelines are listed in every table entry alongside the
+// matching destination's, as well as in default_pipelines, so they get a copy
+// regardless of which (if any) selector a record matches.
+func ApplyRoutingConnectors(dests *odigosv1.DestinationList, currentConfig *commonconf.Config) error {
+	for _, signal := range routedSignals {
+		if err := applyRoutingConnectorForSignal(signal, dests, currentConfig); err != nil {
+			return fmt.Errorf("routing connector for %s: %w", signal, err)
+		}
+	}
+	return nil
+}
+
+func applyRoutingConnectorForSignal(signal string, dests *odigosv1.DestinationList, currentConfig *commonconf.Config) error {
+	selected := []odigosv1.Destination{}
+	for _, dest := range dests.Items {
+		if dest.Spec.Selector == nil {
+			continue
+		}
+		if !signalEnabledForDestination(signal, &dest) {
+			continue
+		}
+		selected = append(selected, dest)
+	}
+
+	if len(selected) == 0 {
+		return nil
+	}
+
+	if err := validateNoAmbiguousSelectors(selected); err != nil {
+		return err
+	}
+
+	connectorName := "routing/" + signal
+	routedPipelines := []string{}
+
+	unselected := []string{}
+	for _, dest := range dests.Items {
+		if dest.Spec.Selector != nil {
+			continue
+		}
+		if !signalEnabledForDestination(signal, &dest) {
+			continue
+		}
+		pipelines := destinationPipelineNames(currentConfig, signal, dest.Name)
+		unselected = append(unselected, pipelines...)
+		routedPipelines = append(routedPipelines, pipelines...)
+	}
+
+	table := []commonconf.GenericMap{}
+	for _, dest := range selected {
+		statement, err := routingStatement(dest.Spec.Selector)
+		if err != nil {
+			return fmt.Errorf("destination %s: %w", dest.Name, err)
+		}
+
+		pipelines := destinationPipelineNames(currentConfig, signal, dest.Name)
+		// Selector-less destinations must keep receiving everything, so they
+		// ride along on every table entry in addition to being the
+		// default_pipelines fallback for records that match no selector.
+		table = append(table, commonconf.GenericMap{
+			"statement": statement,
+			"pipelines": append(append([]string{}, pipelines...), unselected...),
+		})
+		routedPipelines = append(routedPipelines, pipelines...)
+	}
+
+	currentConfig.Connectors[connectorName] = commonconf.GenericMap{
+		"default_pipelines": unselected,
+		"table":             table,
+	}
+
+	// Every destination pipeline the table/default_pipelines can forward
+	// into must declare the connector as a receiver, or the collector
+	// rejects the config as an exporter with nowhere to deliver to.
+	for _, pipelineName := range routedPipelines {
+		pipeline := currentConfig.Service.Pipelines[pipelineName]
+		pipeline.Receivers = append(pipeline.Receivers, connectorName)
+		currentConfig.Service.Pipelines[pipelineName] = pipeline
+	}
+
+	inPipelineName := signal + "/in"
+	currentConfig.Service.Pipelines[inPipelineName] = commonconf.Pipeline{
+		Exporters: []string{connectorName},
+	}
+
+	return nil
+}
+
+// routingStatement translates a Destination's selector into the OTTL
+// `route()` statement the routing connector table expects. Selector keys
+// like `k8s.namespace.name` and `service.name` live on the resource, not on
+// individual spans/datapoints/log records, so the statement matches against
+// `resource.attributes`.
+func routingStatement(selector *odigosv1.DestinationSelector) (string, error) {
+	attr := fmt.Sprintf("resource.attributes[%q]", selector.Key)
+
+	switch {
+	case selector.Equals != "":
+		return fmt.Sprintf("route() where %s == %q", attr, selector.Equals), nil
+	case selector.Regex != "":
+		return fmt.Sprintf("route() where IsMatch(%s, %q)", attr, selector.Regex), nil
+	case selector.Glob != "":
+		return fmt.Sprintf("route() where IsMatch(%s, %q)", attr, globToRegex(selector.Glob)), nil
+	default:
+		return "", fmt.Errorf("selector for key %q has no equals/regex/glob set", selector.Key)
+	}
+}
+
+func globToRegex(glob string) string {
+	escaped := strings.ReplaceAll(glob, ".", `\.`)
+	return "^" + strings.ReplaceAll(escaped, "*", ".*") + "$"
+}
+
+// validateNoAmbiguousSelectors rejects selector sets where two destinations
+// for the same signal could both match the same record, since the routing
+// connector sends a record into every table entry it matches and that would
+// silently fan it out to both rather than picking one.
+//
+// Exact-value overlap (one selector's Equals literal matching against
+// another's Regex/Glob pattern) is detected. Two distinct regex/glob patterns
+// that happen to overlap on some value neither literally repeats (e.g.
+// "prod.*" vs ".*-prod") are not — general regex overlap detection is
+// undecidable in general, so that case is left as a documented limitation
+// rather than a false positive on unrelated patterns.
+func validateNoAmbiguousSelectors(dests []odigosv1.Destination) error {
+	for i := range dests {
+		for j := i + 1; j < len(dests); j++ {
+			a, b := dests[i].Spec.Selector, dests[j].Spec.Selector
+			if a.Key != b.Key {
+				continue
+			}
+
+			overlap, err := selectorsOverlap(a, b)
+			if err != nil {
+				return fmt.Errorf("destinations %q and %q: %w", dests[i].Name, dests[j].Name, err)
+			}
+			if overlap {
+				return fmt.Errorf("destinations %q and %q declare overlapping selectors on key %q, ambiguous routing", dests[i].Name, dests[j].Name, a.Key)
+			}
+		}
+	}
+	return nil
+}
+
+// selectorsOverlap reports whether a and b (already known to share a Key)
+// could both match the same value.
+func selectorsOverlap(a, b *odigosv1.DestinationSelector) (bool, error) {
+	if a.Equals != "" && b.Equals != "" {
+		return a.Equals == b.Equals, nil
+	}
+	if a.Equals != "" {
+		return selectorMatches(b, a.Equals)
+	}
+	if b.Equals != "" {
+		return selectorMatches(a, b.Equals)
+	}
+	if a.Regex != "" && a.Regex == b.Regex {
+		return true, nil
+	}
+	if a.Glob != "" && a.Glob == b.Glob {
+		return true, nil
+	}
+	return false, nil
+}
+
+// selectorMatches tests whether an exact value would match a pattern-based
+// selector (Regex/Glob).
+func selectorMatches(selector *odigosv1.DestinationSelector, value string) (bool, error) {
+	switch {
+	case selector.Regex != "":
+		return regexp.MatchString(selector.Regex, value)
+	case selector.Glob != "":
+		return regexp.MatchString(globToRegex(selector.Glob), value)
+	default:
+		return false, nil
+	}
+}
+
+func signalEnabledForDestination(signal string, dest *odigosv1.Destination) bool {
+	switch signal {
+	case "traces":
+		return isTracingEnabled(dest)
+	case "metrics":
+		return isMetricsEnabled(dest)
+	case "logs":
+		return isLoggingEnabled(dest)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,145 @@
+package config
+
+import (
+	"testing"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRoutingStatement(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector *odigosv1.DestinationSelector
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "equals",
+			selector: &odigosv1.DestinationSelector{Key: "k8s.namespace.name", Equals: "prod"},
+			want:     `route() where resource.attributes["k8s.namespace.name"] == "prod"`,
+		},
+		{
+			name:     "regex",
+			selector: &odigosv1.DestinationSelector{Key: "service.name", Regex: "^checkout-.*$"},
+			want:     `route() where IsMatch(resource.attributes["service.name"], "^checkout-.*$")`,
+		},
+		{
+			name:     "glob",
+			selector: &odigosv1.DestinationSelector{Key: "service.name", Glob: "checkout-*"},
+			want:     `route() where IsMatch(resource.attributes["service.name"], "^checkout-.*$")`,
+		},
+		{
+			name:     "no condition set is an error",
+			selector: &odigosv1.DestinationSelector{Key: "service.name"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := routingStatement(tt.selector)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("routingStatement() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Fatalf("routingStatement() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGlobToRegex(t *testing.T) {
+	tests := []struct {
+		glob    string
+		matches []string
+		rejects []string
+	}{
+		{
+			glob:    "checkout-*",
+			matches: []string{"checkout-prod", "checkout-"},
+			rejects: []string{"checkout", "other-checkout-prod"},
+		},
+		{
+			glob:    "*.prod.svc",
+			matches: []string{"checkout.prod.svc", ".prod.svc"},
+			rejects: []string{"checkout.prod.svcx"},
+		},
+	}
+
+	for _, tt := range tests {
+		re := globToRegex(tt.glob)
+		for _, m := range tt.matches {
+			matched, err := regexpMatch(re, m)
+			if err != nil {
+				t.Fatalf("invalid regex %q generated from glob %q: %v", re, tt.glob, err)
+			}
+			if !matched {
+				t.Errorf("globToRegex(%q) = %q, expected to match %q", tt.glob, re, m)
+			}
+		}
+		for _, r := range tt.rejects {
+			matched, err := regexpMatch(re, r)
+			if err != nil {
+				t.Fatalf("invalid regex %q generated from glob %q: %v", re, tt.glob, err)
+			}
+			if matched {
+				t.Errorf("globToRegex(%q) = %q, expected not to match %q", tt.glob, re, r)
+			}
+		}
+	}
+}
+
+func TestValidateNoAmbiguousSelectors(t *testing.T) {
+	tests := []struct {
+		name    string
+		dests   []odigosv1.Destination
+		wantErr bool
+	}{
+		{
+			name: "different keys never overlap",
+			dests: []odigosv1.Destination{
+				{ObjectMeta: metav1.ObjectMeta{Name: "a"}, Spec: odigosv1.DestinationSpec{Selector: &odigosv1.DestinationSelector{Key: "k8s.namespace.name", Equals: "prod"}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "b"}, Spec: odigosv1.DestinationSpec{Selector: &odigosv1.DestinationSelector{Key: "service.name", Equals: "prod"}}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "identical selectors are ambiguous",
+			dests: []odigosv1.Destination{
+				{ObjectMeta: metav1.ObjectMeta{Name: "a"}, Spec: odigosv1.DestinationSpec{Selector: &odigosv1.DestinationSelector{Key: "k8s.namespace.name", Equals: "prod"}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "b"}, Spec: odigosv1.DestinationSpec{Selector: &odigosv1.DestinationSelector{Key: "k8s.namespace.name", Equals: "prod"}}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "an exact value overlapping a regex on the same key is ambiguous",
+			dests: []odigosv1.Destination{
+				{ObjectMeta: metav1.ObjectMeta{Name: "a"}, Spec: odigosv1.DestinationSpec{Selector: &odigosv1.DestinationSelector{Key: "k8s.namespace.name", Equals: "prod"}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "b"}, Spec: odigosv1.DestinationSpec{Selector: &odigosv1.DestinationSelector{Key: "k8s.namespace.name", Regex: "prod.*"}}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "an exact value not matching a regex on the same key is fine",
+			dests: []odigosv1.Destination{
+				{ObjectMeta: metav1.ObjectMeta{Name: "a"}, Spec: odigosv1.DestinationSpec{Selector: &odigosv1.DestinationSelector{Key: "k8s.namespace.name", Equals: "staging"}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "b"}, Spec: odigosv1.DestinationSpec{Selector: &odigosv1.DestinationSelector{Key: "k8s.namespace.name", Regex: "prod.*"}}},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateNoAmbiguousSelectors(tt.dests)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateNoAmbiguousSelectors() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func regexpMatch(pattern, value string) (bool, error) {
+	return selectorMatches(&odigosv1.DestinationSelector{Regex: pattern}, value)
+}
@@ -0,0 +1,210 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+
+	"github.com/odigos-io/odigos/common"
+)
+
+// FieldKind describes how a DestinationSchemaField's raw string value (taken
+// from Destination.Spec.Data) should be interpreted and validated.
+type FieldKind string
+
+const (
+	FieldKindString   FieldKind = "string"
+	FieldKindURL      FieldKind = "url"
+	FieldKindEnum     FieldKind = "enum"
+	FieldKindSecret   FieldKind = "secret"
+	FieldKindJSONList FieldKind = "json-list"
+)
+
+// DestinationSchemaField describes a single key a destination type reads out
+// of Destination.Spec.Data (or, for FieldKindSecret, out of the destination's
+// referenced Kubernetes Secret).
+type DestinationSchemaField struct {
+	Name          string
+	Kind          FieldKind
+	Required      bool
+	Regex         string
+	AllowedValues []string
+	// PathMustBe constrains FieldKindURL values to a single allowed URL path,
+	// e.g. grafana cloud Loki's "/loki/api/v1/push".
+	PathMustBe string
+	// SecretEnvVar is the name of the environment variable the gateway
+	// container expects this value under, for FieldKindSecret fields that are
+	// never stored in Destination.Spec.Data.
+	SecretEnvVar string
+}
+
+// DestinationSchema is the typed description of the fields a destination
+// type accepts. It's the single source of truth ModifyConfig validation, the
+// (future) admission webhook, and the UI/CLI destination forms are generated
+// from.
+type DestinationSchema struct {
+	Type   common.DestinationType
+	Fields []DestinationSchemaField
+}
+
+var destinationSchemas = map[common.DestinationType]DestinationSchema{}
+
+// RegisterDestinationSchema registers a destination type's typed schema. It
+// is expected to be called once per destination type from that type's own
+// file, via an init() func, mirroring how each type already declares its own
+// Data keys as constants.
+func RegisterDestinationSchema(schema DestinationSchema) {
+	destinationSchemas[schema.Type] = schema
+}
+
+// DestinationSchemas returns every registered schema, for generating the JSON
+// document the UI/CLI use to render destination forms and flags.
+func DestinationSchemas() []DestinationSchema {
+	schemas := make([]DestinationSchema, 0, len(destinationSchemas))
+	for _, schema := range destinationSchemas {
+		schemas = append(schemas, schema)
+	}
+	return schemas
+}
+
+// ParsedDestinationData is the validated view over a Destination's Spec.Data
+// that ValidateDestinationData hands back to a Configurer's ModifyConfig, so
+// destination files read typed values instead of re-parsing raw strings out
+// of the map themselves.
+type ParsedDestinationData struct {
+	raw map[string]string
+}
+
+// String returns the raw value for key, and whether it was present and
+// non-empty.
+func (p *ParsedDestinationData) String(key string) (string, bool) {
+	value, exists := p.raw[key]
+	if !exists || value == "" {
+		return "", false
+	}
+	return value, true
+}
+
+// Bool parses key as a bool, returning false/false/nil when it's unset.
+func (p *ParsedDestinationData) Bool(key string) (bool, bool, error) {
+	value, exists := p.String(key)
+	if !exists {
+		return false, false, nil
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, true, fmt.Errorf("field %q: %w", key, err)
+	}
+	return parsed, true, nil
+}
+
+// Int parses key as an int, returning 0/false/nil when it's unset.
+func (p *ParsedDestinationData) Int(key string) (int, bool, error) {
+	value, exists := p.String(key)
+	if !exists {
+		return 0, false, nil
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, true, fmt.Errorf("field %q: %w", key, err)
+	}
+	return parsed, true, nil
+}
+
+// JSONList parses key as a JSON array of strings, returning nil/false/nil
+// when it's unset.
+func (p *ParsedDestinationData) JSONList(key string) ([]string, bool, error) {
+	value, exists := p.String(key)
+	if !exists {
+		return nil, false, nil
+	}
+	var list []string
+	if err := json.Unmarshal([]byte(value), &list); err != nil {
+		return nil, true, fmt.Errorf("field %q: %w", key, err)
+	}
+	return list, true, nil
+}
+
+// ValidateDestinationData validates a Destination's Spec.Data map against its
+// type's registered schema, returning a ParsedDestinationData Configurers can
+// read typed values out of. Destination types that haven't registered a
+// schema yet are skipped, so this can be adopted incrementally.
+func ValidateDestinationData(destType common.DestinationType, data map[string]string) (*ParsedDestinationData, error) {
+	parsed := &ParsedDestinationData{raw: data}
+
+	schema, exists := destinationSchemas[destType]
+	if !exists {
+		return parsed, nil
+	}
+
+	for _, field := range schema.Fields {
+		if field.Kind == FieldKindSecret {
+			// secret values never flow through Spec.Data, nothing to validate here.
+			continue
+		}
+
+		value, exists := data[field.Name]
+		if !exists || value == "" {
+			if field.Required {
+				return nil, fmt.Errorf("missing required field %q", field.Name)
+			}
+			continue
+		}
+
+		if err := validateFieldValue(field, value); err != nil {
+			return nil, fmt.Errorf("field %q: %w", field.Name, err)
+		}
+	}
+
+	return parsed, nil
+}
+
+func validateURLField(field DestinationSchemaField, value string) error {
+	parsedUrl, err := url.Parse(value)
+	if err != nil {
+		return err
+	}
+
+	if parsedUrl.Scheme == "" || parsedUrl.Host == "" {
+		return fmt.Errorf("value %q is not an absolute URL", value)
+	}
+
+	if field.PathMustBe != "" && parsedUrl.Path != field.PathMustBe {
+		return fmt.Errorf("path must be %q, got %q", field.PathMustBe, parsedUrl.Path)
+	}
+
+	return nil
+}
+
+func validateFieldValue(field DestinationSchemaField, value string) error {
+	switch field.Kind {
+	case FieldKindEnum:
+		for _, allowed := range field.AllowedValues {
+			if value == allowed {
+				return nil
+			}
+		}
+		return fmt.Errorf("value %q is not one of %v", value, field.AllowedValues)
+	case FieldKindURL:
+		return validateURLField(field, value)
+	case FieldKindJSONList:
+		var list []string
+		if err := json.Unmarshal([]byte(value), &list); err != nil {
+			return fmt.Errorf("value %q is not a valid JSON list of strings: %w", value, err)
+		}
+	}
+
+	if field.Regex != "" {
+		matched, err := regexp.MatchString(field.Regex, value)
+		if err != nil {
+			return fmt.Errorf("invalid regex %q on schema: %w", field.Regex, err)
+		}
+		if !matched {
+			return fmt.Errorf("value %q does not match pattern %q", value, field.Regex)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/odigos-io/odigos/common"
+)
+
+const testDestType common.DestinationType = "test-schema-dest"
+
+func registerTestSchema(t *testing.T, fields ...DestinationSchemaField) {
+	t.Helper()
+	destinationSchemas[testDestType] = DestinationSchema{
+		Type:   testDestType,
+		Fields: fields,
+	}
+	t.Cleanup(func() {
+		delete(destinationSchemas, testDestType)
+	})
+}
+
+func TestValidateDestinationData(t *testing.T) {
+	tests := []struct {
+		name    string
+		fields  []DestinationSchemaField
+		data    map[string]string
+		wantErr bool
+	}{
+		{
+			name:    "missing required field",
+			fields:  []DestinationSchemaField{{Name: "URL", Kind: FieldKindURL, Required: true}},
+			data:    map[string]string{},
+			wantErr: true,
+		},
+		{
+			name:    "missing optional field is fine",
+			fields:  []DestinationSchemaField{{Name: "URL", Kind: FieldKindURL, Required: false}},
+			data:    map[string]string{},
+			wantErr: false,
+		},
+		{
+			name:    "valid absolute url",
+			fields:  []DestinationSchemaField{{Name: "URL", Kind: FieldKindURL, Required: true}},
+			data:    map[string]string{"URL": "https://example.com/push"},
+			wantErr: false,
+		},
+		{
+			name:    "url missing scheme and host",
+			fields:  []DestinationSchemaField{{Name: "URL", Kind: FieldKindURL, Required: true}},
+			data:    map[string]string{"URL": "not-a-url"},
+			wantErr: true,
+		},
+		{
+			name:    "url with wrong required path",
+			fields:  []DestinationSchemaField{{Name: "URL", Kind: FieldKindURL, Required: true, PathMustBe: "/loki/api/v1/push"}},
+			data:    map[string]string{"URL": "https://example.com"},
+			wantErr: true,
+		},
+		{
+			name:    "url with bare root path does not satisfy PathMustBe",
+			fields:  []DestinationSchemaField{{Name: "URL", Kind: FieldKindURL, Required: true, PathMustBe: "/loki/api/v1/push"}},
+			data:    map[string]string{"URL": "https://example.com/"},
+			wantErr: true,
+		},
+		{
+			name:    "url with matching required path",
+			fields:  []DestinationSchemaField{{Name: "URL", Kind: FieldKindURL, Required: true, PathMustBe: "/loki/api/v1/push"}},
+			data:    map[string]string{"URL": "https://example.com/loki/api/v1/push"},
+			wantErr: false,
+		},
+		{
+			name:    "enum value not allowed",
+			fields:  []DestinationSchemaField{{Name: "COMPRESSION", Kind: FieldKindEnum, AllowedValues: []string{"gzip", "none"}}},
+			data:    map[string]string{"COMPRESSION": "brotli"},
+			wantErr: true,
+		},
+		{
+			name:    "enum value allowed",
+			fields:  []DestinationSchemaField{{Name: "COMPRESSION", Kind: FieldKindEnum, AllowedValues: []string{"gzip", "none"}}},
+			data:    map[string]string{"COMPRESSION": "gzip"},
+			wantErr: false,
+		},
+		{
+			name:    "json-list invalid json",
+			fields:  []DestinationSchemaField{{Name: "LABELS", Kind: FieldKindJSONList}},
+			data:    map[string]string{"LABELS": "not-json"},
+			wantErr: true,
+		},
+		{
+			name:    "json-list valid json",
+			fields:  []DestinationSchemaField{{Name: "LABELS", Kind: FieldKindJSONList}},
+			data:    map[string]string{"LABELS": `["a", "b"]`},
+			wantErr: false,
+		},
+		{
+			name:    "string field failing regex",
+			fields:  []DestinationSchemaField{{Name: "REALM", Kind: FieldKindString, Regex: "^[a-z0-9-]+$"}},
+			data:    map[string]string{"REALM": "Not_Valid"},
+			wantErr: true,
+		},
+		{
+			name:    "secret fields are never read from Spec.Data",
+			fields:  []DestinationSchemaField{{Name: "API_KEY", Kind: FieldKindSecret, Required: true, SecretEnvVar: "API_KEY"}},
+			data:    map[string]string{},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registerTestSchema(t, tt.fields...)
+
+			_, err := ValidateDestinationData(testDestType, tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateDestinationData() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateDestinationDataUnregisteredTypeIsSkipped(t *testing.T) {
+	data, err := ValidateDestinationData(common.DestinationType("unregistered"), map[string]string{"anything": "goes"})
+	if err != nil {
+		t.Fatalf("expected no error for an unregistered destination type, got %v", err)
+	}
+	if data == nil {
+		t.Fatal("expected a non-nil ParsedDestinationData even when no schema is registered")
+	}
+}
+
+func TestParsedDestinationDataAccessors(t *testing.T) {
+	data := &ParsedDestinationData{raw: map[string]string{
+		"STRING": "hello",
+		"BOOL":   "true",
+		"INT":    "42",
+		"LIST":   `["a","b"]`,
+	}}
+
+	if v, ok := data.String("STRING"); !ok || v != "hello" {
+		t.Fatalf("String() = %q, %v, want %q, true", v, ok, "hello")
+	}
+	if _, ok := data.String("MISSING"); ok {
+		t.Fatal("String() for a missing key should report not-present")
+	}
+
+	if v, ok, err := data.Bool("BOOL"); err != nil || !ok || !v {
+		t.Fatalf("Bool() = %v, %v, %v, want true, true, nil", v, ok, err)
+	}
+	if _, ok, err := data.Bool("MISSING"); err != nil || ok {
+		t.Fatalf("Bool() for a missing key should report not-present with no error, got %v, %v, %v", false, ok, err)
+	}
+
+	if v, ok, err := data.Int("INT"); err != nil || !ok || v != 42 {
+		t.Fatalf("Int() = %v, %v, %v, want 42, true, nil", v, ok, err)
+	}
+
+	if v, ok, err := data.JSONList("LIST"); err != nil || !ok || len(v) != 2 {
+		t.Fatalf("JSONList() = %v, %v, %v, want [a b], true, nil", v, ok, err)
+	}
+}
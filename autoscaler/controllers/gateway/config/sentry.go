@@ -7,13 +7,24 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
+const sentryDsn = "DSN"
+
+func init() {
+	RegisterDestinationSchema(DestinationSchema{
+		Type: common.SentryDestinationType,
+		Fields: []DestinationSchemaField{
+			{Name: sentryDsn, Kind: FieldKindSecret, Required: true, SecretEnvVar: sentryDsn},
+		},
+	})
+}
+
 type Sentry struct{}
 
 func (s *Sentry) DestType() common.DestinationType {
 	return common.SentryDestinationType
 }
 
-func (s *Sentry) ModifyConfig(dest *odigosv1.Destination, currentConfig *commonconf.Config) {
+func (s *Sentry) ModifyConfig(dest *odigosv1.Destination, data *ParsedDestinationData, currentConfig *commonconf.Config) {
 	if !isTracingEnabled(dest) {
 		log.Log.V(0).Info("Sentry is not enabled for any supported signals, skipping")
 		return
@@ -0,0 +1,76 @@
+package config
+
+import (
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	commonconf "github.com/odigos-io/odigos/autoscaler/controllers/common"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// ApplyServiceGraphConnector wires the OTel Collector `servicegraph`
+// connector into the gateway config for every enabled ServiceGraph action.
+// Traces are fanned into the connector, which derives RED-style edge metrics
+// (request counts, latency histograms, failed request counts) and feeds them
+// back into `metrics/<connector>`, so every subscribed metrics destination
+// receives the derived metrics alongside its regular traces/metrics.
+func ApplyServiceGraphConnector(serviceGraphActions *odigosv1.ServiceGraphList, dests *odigosv1.DestinationList, currentConfig *commonconf.Config) {
+	for _, serviceGraph := range serviceGraphActions.Items {
+		if !serviceGraph.Spec.Enabled {
+			continue
+		}
+
+		connectorName := "servicegraph/" + serviceGraph.Name
+		currentConfig.Connectors[connectorName] = serviceGraphConnectorConfig(&serviceGraph)
+
+		metricsDestinations := []string{}
+		for _, dest := range dests.Items {
+			if !isMetricsEnabled(&dest) {
+				continue
+			}
+			if len(serviceGraph.Spec.DestinationsNames) > 0 && !containsString(serviceGraph.Spec.DestinationsNames, dest.Name) {
+				continue
+			}
+			metricsDestinations = append(metricsDestinations, destinationExporters(currentConfig, "metrics", dest.Name)...)
+		}
+
+		if len(metricsDestinations) == 0 {
+			log.Log.V(0).Info("ServiceGraph has no matching metrics destinations, connector will not receive a pipeline", "name", serviceGraph.Name)
+			continue
+		}
+
+		// Feed the connector from every trace pipeline already built for the
+		// gateway, instead of creating a standalone source pipeline with no
+		// receiver of its own.
+		attachConnectorAsExporter(currentConfig, "traces", connectorName)
+
+		metricsPipelineName := "metrics/" + connectorName
+		currentConfig.Service.Pipelines[metricsPipelineName] = commonconf.Pipeline{
+			Receivers: []string{connectorName},
+			Exporters: metricsDestinations,
+		}
+	}
+}
+
+func serviceGraphConnectorConfig(serviceGraph *odigosv1.ServiceGraph) commonconf.GenericMap {
+	conf := commonconf.GenericMap{}
+
+	if len(serviceGraph.Spec.LatencyHistogramBuckets) > 0 {
+		conf["latency_histogram_buckets"] = serviceGraph.Spec.LatencyHistogramBuckets
+	}
+
+	if len(serviceGraph.Spec.Dimensions) > 0 {
+		conf["dimensions"] = serviceGraph.Spec.Dimensions
+	}
+
+	store := commonconf.GenericMap{}
+	if serviceGraph.Spec.StoreTTL != "" {
+		store["ttl"] = serviceGraph.Spec.StoreTTL
+	}
+	if serviceGraph.Spec.StoreMaxItems > 0 {
+		store["max_items"] = serviceGraph.Spec.StoreMaxItems
+	}
+	if len(store) > 0 {
+		conf["store"] = store
+	}
+
+	return conf
+}
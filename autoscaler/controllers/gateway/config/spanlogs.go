@@ -0,0 +1,73 @@
+package config
+
+import (
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	commonconf "github.com/odigos-io/odigos/autoscaler/controllers/common"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// ApplySpanLogsConnector wires the OTel Collector `spanlogs` connector into
+// the gateway config for every enabled SpanLogs action. Traces entering the
+// gateway pipelines are fanned into the connector, which synthesizes a log
+// record per span/root/process and feeds it back into `logs/<connector>`, so
+// every logging-enabled destination also receives the derived logs.
+func ApplySpanLogsConnector(spanLogsActions *odigosv1.SpanLogsList, dests *odigosv1.DestinationList, currentConfig *commonconf.Config) {
+	for _, spanLogs := range spanLogsActions.Items {
+		if !spanLogs.Spec.Enabled {
+			continue
+		}
+
+		connectorName := "spanlogs/" + spanLogs.Name
+		currentConfig.Connectors[connectorName] = spanLogsConnectorConfig(&spanLogs)
+
+		logsDestinations := []string{}
+		for _, dest := range dests.Items {
+			if !isLoggingEnabled(&dest) {
+				continue
+			}
+			if len(spanLogs.Spec.DestinationsNames) > 0 && !containsString(spanLogs.Spec.DestinationsNames, dest.Name) {
+				continue
+			}
+			logsDestinations = append(logsDestinations, destinationExporters(currentConfig, "logs", dest.Name)...)
+		}
+
+		if len(logsDestinations) == 0 {
+			log.Log.V(0).Info("SpanLogs has no matching logging destinations, connector will not receive a pipeline", "name", spanLogs.Name)
+			continue
+		}
+
+		// Feed the connector from every trace pipeline already built for the
+		// gateway, instead of creating a standalone source pipeline with no
+		// receiver of its own.
+		attachConnectorAsExporter(currentConfig, "traces", connectorName)
+
+		logsPipelineName := "logs/" + connectorName
+		currentConfig.Service.Pipelines[logsPipelineName] = commonconf.Pipeline{
+			Receivers: []string{connectorName},
+			Exporters: logsDestinations,
+		}
+	}
+}
+
+func spanLogsConnectorConfig(spanLogs *odigosv1.SpanLogs) commonconf.GenericMap {
+	conf := commonconf.GenericMap{
+		"roots":     spanLogs.Spec.IncludeRoot,
+		"processes": spanLogs.Spec.IncludeProcess,
+		"spans":     spanLogs.Spec.IncludeSpans,
+	}
+
+	if len(spanLogs.Spec.AttributeKeys) > 0 {
+		conf["labels"] = spanLogs.Spec.AttributeKeys
+	}
+
+	return conf
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -10,17 +10,28 @@ import (
 )
 
 const (
-	splunkRealm = "SPLUNK_REALM"
+	splunkRealm       = "SPLUNK_REALM"
+	splunkAccessToken = "SPLUNK_ACCESS_TOKEN"
 )
 
+func init() {
+	RegisterDestinationSchema(DestinationSchema{
+		Type: common.SplunkDestinationType,
+		Fields: []DestinationSchemaField{
+			{Name: splunkRealm, Kind: FieldKindString, Required: true, Regex: "^[a-z0-9-]+$"},
+			{Name: splunkAccessToken, Kind: FieldKindSecret, Required: true, SecretEnvVar: splunkAccessToken},
+		},
+	})
+}
+
 type Splunk struct{}
 
 func (s *Splunk) DestType() common.DestinationType {
 	return common.SplunkDestinationType
 }
 
-func (s *Splunk) ModifyConfig(dest *odigosv1.Destination, currentConfig *commonconf.Config) {
-	realm, exists := dest.Spec.Data[splunkRealm]
+func (s *Splunk) ModifyConfig(dest *odigosv1.Destination, data *ParsedDestinationData, currentConfig *commonconf.Config) {
+	realm, exists := data.String(splunkRealm)
 	if !exists {
 		log.Log.V(0).Info("Splunk realm not specified, gateway will not be configured for Splunk")
 		return
@@ -0,0 +1,147 @@
+package gateway
+
+import (
+	"context"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/common/utils"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	k8sAttributesClusterRoleName = "odigos-gateway-k8sattributes"
+)
+
+// syncK8sAttributesRBAC ensures the ServiceAccount, ClusterRole and
+// ClusterRoleBinding the gateway's k8sattributes processor needs to watch
+// pods/namespaces/replicasets cluster-wide exist, and removes the
+// ClusterRole/ClusterRoleBinding again once the processor is disabled (the
+// ServiceAccount stays, since the gateway deployment always references it).
+func syncK8sAttributesRBAC(ctx context.Context, c client.Client, scheme *runtime.Scheme, gateway *odigosv1.CollectorsGroup, odigosConfig *odigosv1.OdigosConfiguration) error {
+	logger := log.FromContext(ctx)
+	ns := utils.GetCurrentNamespace()
+
+	if _, err := syncServiceAccount(ctx, c, scheme, gateway, ns); err != nil {
+		logger.Error(err, "Failed to sync gateway service account")
+		return err
+	}
+
+	enabled := odigosConfig.Spec.K8sAttributes != nil && odigosConfig.Spec.K8sAttributes.Enabled
+	if !enabled {
+		return deleteK8sAttributesClusterRBAC(ctx, c)
+	}
+
+	clusterRole := &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   k8sAttributesClusterRoleName,
+			Labels: commonLabels,
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{""},
+				Resources: []string{"pods", "namespaces"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+			{
+				APIGroups: []string{"apps"},
+				Resources: []string{"replicasets"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+		},
+	}
+
+	if err := createOrUpdateClusterObject(ctx, c, clusterRole, func(existing *rbacv1.ClusterRole) {
+		existing.Rules = clusterRole.Rules
+	}); err != nil {
+		logger.Error(err, "Failed to sync gateway k8sattributes cluster role")
+		return err
+	}
+
+	clusterRoleBinding := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   k8sAttributesClusterRoleName,
+			Labels: commonLabels,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     k8sAttributesClusterRoleName,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      kubeObjectName,
+				Namespace: ns,
+			},
+		},
+	}
+
+	if err := createOrUpdateClusterObject(ctx, c, clusterRoleBinding, func(existing *rbacv1.ClusterRoleBinding) {
+		existing.RoleRef = clusterRoleBinding.RoleRef
+		existing.Subjects = clusterRoleBinding.Subjects
+	}); err != nil {
+		logger.Error(err, "Failed to sync gateway k8sattributes cluster role binding")
+		return err
+	}
+
+	return nil
+}
+
+func syncServiceAccount(ctx context.Context, c client.Client, scheme *runtime.Scheme, gateway *odigosv1.CollectorsGroup, ns string) (*corev1.ServiceAccount, error) {
+	sa := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      kubeObjectName,
+			Namespace: ns,
+			Labels:    commonLabels,
+		},
+	}
+
+	if err := controllerutil.SetControllerReference(gateway, sa, scheme); err != nil {
+		return nil, err
+	}
+
+	var existing corev1.ServiceAccount
+	err := c.Get(ctx, types.NamespacedName{Name: sa.Name, Namespace: sa.Namespace}, &existing)
+	if apierrors.IsNotFound(err) {
+		if err := c.Create(ctx, sa); err != nil {
+			return nil, err
+		}
+		return sa, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &existing, nil
+}
+
+func deleteK8sAttributesClusterRBAC(ctx context.Context, c client.Client) error {
+	if err := c.Delete(ctx, &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: k8sAttributesClusterRoleName}}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err := c.Delete(ctx, &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: k8sAttributesClusterRoleName}}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func createOrUpdateClusterObject[T client.Object](ctx context.Context, c client.Client, desired T, update func(existing T)) error {
+	var existing T
+	existing = desired.DeepCopyObject().(T)
+	err := c.Get(ctx, types.NamespacedName{Name: desired.GetName()}, existing)
+	if apierrors.IsNotFound(err) {
+		return c.Create(ctx, desired)
+	} else if err != nil {
+		return err
+	}
+
+	update(existing)
+	return c.Update(ctx, existing)
+}
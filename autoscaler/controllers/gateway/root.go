@@ -57,6 +57,18 @@ func Sync(ctx context.Context, client client.Client, scheme *runtime.Scheme, ima
 		return err
 	}
 
+	var spanLogsActions odigosv1.SpanLogsList
+	if err := client.List(ctx, &spanLogsActions); err != nil {
+		logger.Error(err, "Failed to list span logs actions")
+		return err
+	}
+
+	var serviceGraphActions odigosv1.ServiceGraphList
+	if err := client.List(ctx, &serviceGraphActions); err != nil {
+		logger.Error(err, "Failed to list service graph actions")
+		return err
+	}
+
 	odigosSystemNamespaceName := utils.GetCurrentNamespace()
 	var odigosConfig odigosv1.OdigosConfiguration
 	if err := client.Get(ctx, types.NamespacedName{Namespace: odigosSystemNamespaceName, Name: consts.DefaultOdigosConfigurationName}, &odigosConfig); err != nil {
@@ -64,10 +76,10 @@ func Sync(ctx context.Context, client client.Client, scheme *runtime.Scheme, ima
 		return err
 	}
 
-	return syncGateway(&dests, &processors, gatewayCollectorGroup, ctx, client, scheme, imagePullSecrets, odigosVersion, &odigosConfig)
+	return syncGateway(&dests, &processors, &spanLogsActions, &serviceGraphActions, gatewayCollectorGroup, ctx, client, scheme, imagePullSecrets, odigosVersion, &odigosConfig)
 }
 
-func syncGateway(dests *odigosv1.DestinationList, processors *odigosv1.ProcessorList,
+func syncGateway(dests *odigosv1.DestinationList, processors *odigosv1.ProcessorList, spanLogsActions *odigosv1.SpanLogsList, serviceGraphActions *odigosv1.ServiceGraphList,
 	gateway *odigosv1.CollectorsGroup, ctx context.Context,
 	c client.Client, scheme *runtime.Scheme, imagePullSecrets []string, odigosVersion string, odigosConfig *odigosv1.OdigosConfiguration) error {
 	logger := log.FromContext(ctx)
@@ -75,7 +87,12 @@ func syncGateway(dests *odigosv1.DestinationList, processors *odigosv1.Processor
 
 	memConfig := getMemoryConfigurations(odigosConfig)
 
-	configData, err := syncConfigMap(dests, processors, gateway, ctx, c, scheme, memConfig)
+	if err := syncK8sAttributesRBAC(ctx, c, scheme, gateway, odigosConfig); err != nil {
+		logger.Error(err, "Failed to sync k8sattributes RBAC")
+		return err
+	}
+
+	configData, err := syncConfigMap(dests, processors, spanLogsActions, serviceGraphActions, gateway, ctx, c, scheme, memConfig, odigosConfig)
 	if err != nil {
 		logger.Error(err, "Failed to sync config map")
 		return err